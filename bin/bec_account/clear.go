@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+)
+
+func runClear(args []string) error {
+	fileCfg, err := loadFileConfig()
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("clear", flag.ExitOnError)
+	rf := bindRedisFlags(fs, fileCfg)
+	force := fs.Bool("force", false, "Confirm removal of the account stream")
+	fs.Parse(args)
+	rf.resolveSecrets(fs)
+
+	if !*force {
+		return fmt.Errorf("refusing to clear %s without --force", accountKey)
+	}
+
+	ctx := context.Background()
+	rdb, err := connect(ctx, rf)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	if err := rdb.Del(ctx, accountKey).Err(); err != nil {
+		return fmt.Errorf("failed to clear %s: %w", accountKey, err)
+	}
+
+	fmt.Println("Account stream", accountKey, "has been cleared.")
+	return nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/bec-project/bec/pkg/redisconn"
+)
+
+// accountKey is the Redis stream that carries the active pgroup.
+const accountKey = "info/account"
+
+// repeatableFlag collects the values of a flag that may be passed more
+// than once, e.g. --redis-sentinel-addr host1:26379 --redis-sentinel-addr host2:26379.
+type repeatableFlag []string
+
+func (r *repeatableFlag) String() string {
+	return fmt.Sprint([]string(*r))
+}
+
+func (r *repeatableFlag) Set(value string) error {
+	*r = append(*r, value)
+	return nil
+}
+
+// redisFlags holds the connection flags shared by every subcommand.
+type redisFlags struct {
+	host     string
+	port     string
+	username string
+	password string
+	db       int
+
+	tls                bool
+	tlsCA              string
+	tlsCert            string
+	tlsKey             string
+	insecureSkipVerify bool
+
+	mode          string
+	masterName    string
+	sentinelAddrs repeatableFlag
+	clusterAddrs  repeatableFlag
+
+	fileCfg redisconn.FileConfig
+}
+
+// bindRedisFlags registers the connection flags on fs. The host, port,
+// DB and TLS flags are defaulted from BEC_REDIS_* environment variables
+// and ~/.bec/config.yaml; username and password are filled in the same
+// way by resolveSecrets. The topology flags (--redis-mode,
+// --redis-master-name, --redis-sentinel-addr, --redis-cluster-addr) are
+// not read from the environment or the config file.
+func bindRedisFlags(fs *flag.FlagSet, fileCfg redisconn.FileConfig) *redisFlags {
+	rf := &redisFlags{fileCfg: fileCfg}
+
+	fs.StringVar(&rf.host, "redis-host", redisconn.StringSetting("BEC_REDIS_HOST", fileCfg.RedisHost, ""), "Redis host (e.g. awi-bec-001)")
+	fs.StringVar(&rf.port, "redis-port", redisconn.StringSetting("BEC_REDIS_PORT", fileCfg.RedisPort, "6379"), "Redis port")
+	fs.StringVar(&rf.username, "redis-username", "", "Redis username (default from BEC_REDIS_USERNAME or ~/.bec/config.yaml)")
+	fs.StringVar(&rf.password, "redis-password", "", "Redis password (default from BEC_REDIS_PASSWORD or ~/.bec/config.yaml)")
+	fs.IntVar(&rf.db, "redis-db", redisconn.IntSetting("BEC_REDIS_DB", fileCfg.RedisDB, 0), "Redis logical database (standalone and sentinel modes only)")
+	fs.BoolVar(&rf.tls, "redis-tls", redisconn.BoolSetting("BEC_REDIS_TLS", fileCfg.RedisTLS, false), "Connect to Redis over TLS")
+	fs.StringVar(&rf.tlsCA, "redis-tls-ca", redisconn.StringSetting("BEC_REDIS_TLS_CA", fileCfg.RedisTLSCA, ""), "Path to a CA bundle to verify the Redis server certificate")
+	fs.StringVar(&rf.tlsCert, "redis-tls-cert", redisconn.StringSetting("BEC_REDIS_TLS_CERT", fileCfg.RedisTLSCert, ""), "Path to a client certificate for TLS")
+	fs.StringVar(&rf.tlsKey, "redis-tls-key", redisconn.StringSetting("BEC_REDIS_TLS_KEY", fileCfg.RedisTLSKey, ""), "Path to the client certificate's private key")
+	fs.BoolVar(&rf.insecureSkipVerify, "redis-insecure-skip-verify", redisconn.BoolSetting("BEC_REDIS_INSECURE_SKIP_VERIFY", fileCfg.RedisInsecureSkipVerify, false), "Skip TLS certificate verification (insecure)")
+
+	fs.StringVar(&rf.mode, "redis-mode", "standalone", "Redis topology: standalone, sentinel, or cluster")
+	fs.StringVar(&rf.masterName, "redis-master-name", "", "Sentinel master name (sentinel mode only)")
+	fs.Var(&rf.sentinelAddrs, "redis-sentinel-addr", "Sentinel address, repeatable (sentinel mode only)")
+	fs.Var(&rf.clusterAddrs, "redis-cluster-addr", "Cluster node address, repeatable (cluster mode only)")
+
+	return rf
+}
+
+// resolveSecrets fills in --redis-username/--redis-password from
+// BEC_REDIS_* environment variables or ~/.bec/config.yaml when the flag
+// was not passed explicitly on the command line. It must run after
+// fs.Parse. Unlike the other connection flags, these defaults are not
+// baked into the flag registration itself, since the standard flag
+// package echoes a flag's default verbatim in its usage/-h output, and
+// credentials must not appear there.
+func (rf *redisFlags) resolveSecrets(fs *flag.FlagSet) {
+	explicit := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+
+	if !explicit["redis-username"] {
+		rf.username = redisconn.StringSetting("BEC_REDIS_USERNAME", rf.fileCfg.RedisUsername, rf.username)
+	}
+	if !explicit["redis-password"] {
+		rf.password = redisconn.StringSetting("BEC_REDIS_PASSWORD", rf.fileCfg.RedisPassword, rf.password)
+	}
+}
+
+// config turns the parsed flags into a redisconn.Config.
+func (rf *redisFlags) config() redisconn.Config {
+	return redisconn.Config{
+		Mode:          redisconn.Mode(rf.mode),
+		Addr:          rf.host + ":" + rf.port,
+		MasterName:    rf.masterName,
+		SentinelAddrs: rf.sentinelAddrs,
+		ClusterAddrs:  rf.clusterAddrs,
+		Username:      rf.username,
+		Password:      rf.password,
+		DB:            rf.db,
+		TLS: redisconn.TLSConfig{
+			Enabled:            rf.tls,
+			CAFile:             rf.tlsCA,
+			CertFile:           rf.tlsCert,
+			KeyFile:            rf.tlsKey,
+			InsecureSkipVerify: rf.insecureSkipVerify,
+		},
+		PingRetries:   3,
+		PingRetryWait: 2 * time.Second,
+	}
+}
+
+// connect validates rf and dials Redis accordingly.
+func connect(ctx context.Context, rf *redisFlags) (redis.UniversalClient, error) {
+	mode := redisconn.Mode(rf.mode)
+	if mode != redisconn.ModeSentinel && mode != redisconn.ModeCluster && rf.host == "" {
+		return nil, fmt.Errorf("missing required argument: --redis-host")
+	}
+	return redisconn.New(ctx, rf.config())
+}
+
+// loadFileConfig reads ~/.bec/config.yaml, tolerating a missing file.
+func loadFileConfig() (redisconn.FileConfig, error) {
+	return redisconn.LoadFileConfig(redisconn.DefaultConfigPath())
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"github.com/bec-project/bec/pkg/beccodec"
+)
+
+func runGet(args []string) error {
+	fileCfg, err := loadFileConfig()
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+	rf := bindRedisFlags(fs, fileCfg)
+	asJSON := fs.Bool("json", false, "Print the result as JSON")
+	fs.Parse(args)
+	rf.resolveSecrets(fs)
+
+	ctx := context.Background()
+	rdb, err := connect(ctx, rf)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	entries, err := rdb.XRevRangeN(ctx, accountKey, "+", "-", 1).Result()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", accountKey, err)
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("no account has been set yet")
+	}
+
+	msgBytes, ok := entries[0].Values["data"].(string)
+	if !ok {
+		return fmt.Errorf("unexpected data format in %s", accountKey)
+	}
+
+	msg, err := beccodec.Decode([]byte(msgBytes))
+	if err != nil {
+		return fmt.Errorf("failed to decode account message: %w", err)
+	}
+
+	varMsg, ok := msg.(*beccodec.VariableMessage)
+	if !ok {
+		if unknown, ok := msg.(*beccodec.UnknownMessage); ok {
+			fmt.Println(unknown.Summary())
+			return nil
+		}
+		return fmt.Errorf("unexpected message type for %s", accountKey)
+	}
+
+	if *asJSON {
+		out, err := json.Marshal(varMsg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal account as JSON: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	fmt.Printf("Current active account: %v\n", varMsg.Value)
+	for k, v := range varMsg.Metadata {
+		fmt.Printf("%s: %s\n", k, v)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/bec-project/bec/pkg/beccodec"
+)
+
+func runLog(args []string) error {
+	fileCfg, err := loadFileConfig()
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("log", flag.ExitOnError)
+	rf := bindRedisFlags(fs, fileCfg)
+	fs.Parse(args)
+	rf.resolveSecrets(fs)
+
+	ctx := context.Background()
+	rdb, err := connect(ctx, rf)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	entries, err := rdb.XRange(ctx, accountKey, "-", "+").Result()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", accountKey, err)
+	}
+	if len(entries) == 0 {
+		fmt.Println("No account history found.")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "STREAM-ID\tTIMESTAMP\tUSER\tPGROUP")
+	for _, entry := range entries {
+		msgBytes, ok := entry.Values["data"].(string)
+		if !ok {
+			fmt.Fprintf(tw, "%s\t?\t?\t?\n", entry.ID)
+			continue
+		}
+
+		msg, err := beccodec.Decode([]byte(msgBytes))
+		if err != nil {
+			fmt.Fprintf(tw, "%s\t?\t?\t?\n", entry.ID)
+			continue
+		}
+
+		switch m := msg.(type) {
+		case *beccodec.VariableMessage:
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%v\n", entry.ID, m.Metadata["timestamp"], m.Metadata["user"], m.Value)
+		case *beccodec.UnknownMessage:
+			fmt.Fprintf(tw, "%s\t%s\n", entry.ID, m.Summary())
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,51 @@
+// Command bec-account manages the active pgroup published on the
+// info/account Redis stream.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func usage() {
+	fmt.Println("Usage: bec-account <set|get|clear|watch|log> [flags]")
+	fmt.Println()
+	fmt.Println("  set    set the active account (pgroup)")
+	fmt.Println("  get    print the currently active account")
+	fmt.Println("  clear  remove the account stream")
+	fmt.Println("  watch  stream account changes to stdout")
+	fmt.Println("  log    print the account change history")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "set":
+		err = runSet(os.Args[2:])
+	case "get":
+		err = runGet(os.Args[2:])
+	case "clear":
+		err = runClear(os.Args[2:])
+	case "watch":
+		err = runWatch(os.Args[2:])
+	case "log":
+		err = runLog(os.Args[2:])
+	case "-h", "--help", "help":
+		usage()
+		return
+	default:
+		fmt.Printf("Unknown subcommand %q\n\n", os.Args[1])
+		usage()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os/user"
+	"regexp"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/bec-project/bec/pkg/beccodec"
+)
+
+func runSet(args []string) error {
+	fileCfg, err := loadFileConfig()
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+	rf := bindRedisFlags(fs, fileCfg)
+	pgroup := fs.String("pgroup", "", "Process group (e.g. p16602)")
+	force := fs.Bool("force", false, "Force overwrite existing account without confirmation")
+	history := fs.Int64("history", 50, "Number of past account changes to keep in the stream")
+	fs.Parse(args)
+	rf.resolveSecrets(fs)
+
+	if matched, _ := regexp.MatchString(`^p\d{5}$`, *pgroup); !matched {
+		return fmt.Errorf("invalid --pgroup format. It must start with 'p' followed by exactly 5 digits (e.g. p12345)")
+	}
+
+	ctx := context.Background()
+	rdb, err := connect(ctx, rf)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	// Check existing account and get user confirmation if needed
+	proceed, err := checkExistingAccount(rdb, ctx, accountKey, *force)
+	if err != nil {
+		return fmt.Errorf("failed to check existing account: %w", err)
+	}
+	if !proceed {
+		return nil
+	}
+
+	currentUser, _ := user.Current()
+	now := time.Now().Format(time.RFC3339)
+
+	msg := beccodec.NewVariableMessage(*pgroup, map[string]string{
+		"timestamp": now,
+		"user":      currentUser.Username,
+	})
+	packed, err := beccodec.Encode(msg)
+	if err != nil {
+		return fmt.Errorf("failed to set account: %w", err)
+	}
+
+	if err := rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: accountKey,
+		Values: map[string]interface{}{"data": packed},
+		MaxLen: *history, // Keep the last --history entries
+		Approx: false,    // Exact trimming
+	}).Err(); err != nil {
+		return fmt.Errorf("failed to set account: %w", err)
+	}
+
+	fmt.Println("Account", *pgroup, "has been set successfully.")
+	return nil
+}
+
+func checkExistingAccount(rdb redis.UniversalClient, ctx context.Context, key string, force bool) (bool, error) {
+	// Check for the most recent stream entry. With --history keeping more
+	// than one entry, XRange would return the oldest one instead of the
+	// account that's actually active.
+	existing, err := rdb.XRevRangeN(ctx, key, "+", "-", 1).Result()
+
+	// Handle actual errors (not just "key not found")
+	if err != nil && err != redis.Nil {
+		return false, err
+	}
+
+	// No existing stream data, proceed
+	if err == redis.Nil || len(existing) == 0 {
+		return true, nil
+	}
+
+	// Extract and handle stream data
+	msgData := existing[0].Values["data"]
+	msgBytes, ok := msgData.(string)
+	if !ok {
+		fmt.Println("Warning: Unexpected data format in existing stream message")
+		return true, nil
+	}
+
+	return handleExistingData([]byte(msgBytes), force), nil
+}
+
+func handleExistingData(data []byte, force bool) bool {
+	msg, err := beccodec.Decode(data)
+	if err != nil {
+		fmt.Printf("Warning: Failed to decode existing message: %v\n", err)
+		return true
+	}
+
+	// Show current account
+	var current interface{}
+	switch m := msg.(type) {
+	case *beccodec.VariableMessage:
+		current = m.Value
+		fmt.Printf("Current active account: %v\n", m.Value)
+		for k, v := range m.Metadata {
+			fmt.Printf("%s: %s\n", k, v)
+		}
+	case *beccodec.UnknownMessage:
+		fmt.Println(m.Summary())
+	}
+
+	if force {
+		return true
+	}
+
+	// Ask for confirmation
+	var input string
+	fmt.Print("Are you sure you want to overwrite it? [y/N]: ")
+	fmt.Scanln(&input)
+	if input != "y" && input != "Y" {
+		fmt.Println("Aborted, old account", current, "remains active.")
+		return false
+	}
+
+	return true
+}
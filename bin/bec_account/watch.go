@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/bec-project/bec/pkg/beccodec"
+)
+
+func runWatch(args []string) error {
+	fileCfg, err := loadFileConfig()
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	rf := bindRedisFlags(fs, fileCfg)
+	consumerGroup := fs.String("consumer-group", "", "Read through this consumer group (XREADGROUP) instead of XREAD")
+	consumerName := fs.String("consumer-name", "bec-account-watch", "Consumer name within --consumer-group")
+	fs.Parse(args)
+	rf.resolveSecrets(fs)
+
+	ctx := context.Background()
+	rdb, err := connect(ctx, rf)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	if *consumerGroup != "" {
+		if err := rdb.XGroupCreateMkStream(ctx, accountKey, *consumerGroup, "$").Err(); err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+			return fmt.Errorf("failed to create consumer group %s: %w", *consumerGroup, err)
+		}
+	}
+
+	lastID := "$"
+	fmt.Println("Watching", accountKey, "for account changes. Press Ctrl-C to stop.")
+
+	for {
+		var streams []redis.XStream
+
+		if *consumerGroup != "" {
+			streams, err = rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+				Group:    *consumerGroup,
+				Consumer: *consumerName,
+				Streams:  []string{accountKey, ">"},
+				Block:    0,
+			}).Result()
+		} else {
+			streams, err = rdb.XRead(ctx, &redis.XReadArgs{
+				Streams: []string{accountKey, lastID},
+				Block:   0,
+			}).Result()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", accountKey, err)
+		}
+
+		for _, stream := range streams {
+			for _, entry := range stream.Messages {
+				lastID = entry.ID
+				printAccountEntry(entry)
+				if *consumerGroup != "" {
+					rdb.XAck(ctx, accountKey, *consumerGroup, entry.ID)
+				}
+			}
+		}
+	}
+}
+
+func printAccountEntry(entry redis.XMessage) {
+	msgBytes, ok := entry.Values["data"].(string)
+	if !ok {
+		fmt.Printf("%s: unexpected data format\n", entry.ID)
+		return
+	}
+
+	msg, err := beccodec.Decode([]byte(msgBytes))
+	if err != nil {
+		fmt.Printf("%s: failed to decode: %v\n", entry.ID, err)
+		return
+	}
+
+	switch m := msg.(type) {
+	case *beccodec.VariableMessage:
+		fmt.Printf("%s: account=%v user=%s timestamp=%s\n", entry.ID, m.Value, m.Metadata["user"], m.Metadata["timestamp"])
+	case *beccodec.UnknownMessage:
+		fmt.Printf("%s: %s\n", entry.ID, m.Summary())
+	}
+}
@@ -0,0 +1,111 @@
+// Package beccodec implements the msgpack envelope BEC uses for every
+// message it publishes to Redis: a `__bec_codec__` wrapper carrying an
+// encoder name, a type name, and the type-specific payload. It keeps a
+// registry of known (encoder name, type name) pairs so new message
+// types can be decoded without the caller needing a fixed struct.
+package beccodec
+
+import (
+	"fmt"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Message is a BEC message payload that knows which encoder/type name
+// identifies it in the envelope.
+type Message interface {
+	Envelope() (encoderName, typeName string)
+}
+
+// envelope mirrors the on-wire `__bec_codec__` wrapper. Data is kept raw
+// so it can be unmarshalled into the concrete type the registry returns
+// for EncoderName/TypeName.
+type envelope struct {
+	BecCodec struct {
+		EncoderName string             `msgpack:"encoder_name"`
+		TypeName    string             `msgpack:"type_name"`
+		Data        msgpack.RawMessage `msgpack:"data"`
+	} `msgpack:"__bec_codec__"`
+}
+
+type factory func() Message
+
+var registry = map[string]factory{}
+
+// Register makes a message type decodable. It should be called from an
+// init() function in the file that defines the message type.
+func Register(encoderName, typeName string, f factory) {
+	registry[registryKey(encoderName, typeName)] = f
+}
+
+func registryKey(encoderName, typeName string) string {
+	return encoderName + "/" + typeName
+}
+
+// Encode wraps msg in the `__bec_codec__` envelope and marshals it to
+// msgpack.
+func Encode(msg Message) ([]byte, error) {
+	encoderName, typeName := msg.Envelope()
+
+	wrapper := struct {
+		BecCodec struct {
+			EncoderName string  `msgpack:"encoder_name"`
+			TypeName    string  `msgpack:"type_name"`
+			Data        Message `msgpack:"data"`
+		} `msgpack:"__bec_codec__"`
+	}{}
+	wrapper.BecCodec.EncoderName = encoderName
+	wrapper.BecCodec.TypeName = typeName
+	wrapper.BecCodec.Data = msg
+
+	return msgpack.Marshal(wrapper)
+}
+
+// Decode unwraps the `__bec_codec__` envelope and, if EncoderName/TypeName
+// is registered, unmarshals the payload into that message type. Unknown
+// message types are returned as an *UnknownMessage rather than failing,
+// so callers can still print a sensible summary for messages this binary
+// doesn't know about yet.
+func Decode(data []byte) (Message, error) {
+	var env envelope
+	if err := msgpack.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("beccodec: failed to decode envelope: %w", err)
+	}
+
+	encoderName := env.BecCodec.EncoderName
+	typeName := env.BecCodec.TypeName
+
+	f, ok := registry[registryKey(encoderName, typeName)]
+	if !ok {
+		return &UnknownMessage{
+			EncoderName: encoderName,
+			TypeName:    typeName,
+			Raw:         env.BecCodec.Data,
+		}, nil
+	}
+
+	msg := f()
+	if err := msgpack.Unmarshal(env.BecCodec.Data, msg); err != nil {
+		return nil, fmt.Errorf("beccodec: failed to decode %s/%s payload: %w", encoderName, typeName, err)
+	}
+	return msg, nil
+}
+
+// UnknownMessage is returned by Decode for an (encoder name, type name)
+// pair that has no registered factory.
+type UnknownMessage struct {
+	EncoderName string
+	TypeName    string
+	Raw         []byte
+}
+
+func (u *UnknownMessage) Envelope() (string, string) {
+	return u.EncoderName, u.TypeName
+}
+
+// Summary returns a one-line, human-readable description of the message,
+// for code that wants to print something sensible without knowing the
+// payload's shape.
+func (u *UnknownMessage) Summary() string {
+	return fmt.Sprintf("unrecognized message (encoder=%s type=%s, %d byte payload)", u.EncoderName, u.TypeName, len(u.Raw))
+}
@@ -0,0 +1,112 @@
+package beccodec
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestEncodeDecodeVariableMessageRoundTrip(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    interface{}
+		metadata map[string]string
+	}{
+		{name: "string value with metadata", value: "p12345", metadata: map[string]string{"user": "alice", "timestamp": "2026-07-29T00:00:00Z"}},
+		{name: "nil metadata", value: "p00001", metadata: nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewVariableMessage(tc.value, tc.metadata)
+
+			data, err := Encode(msg)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+
+			decoded, err := Decode(data)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+
+			got, ok := decoded.(*VariableMessage)
+			if !ok {
+				t.Fatalf("Decode() returned %T, want *VariableMessage", decoded)
+			}
+			if !reflect.DeepEqual(got.Value, tc.value) {
+				t.Errorf("Value = %v, want %v", got.Value, tc.value)
+			}
+			if len(got.Metadata) != len(tc.metadata) {
+				t.Errorf("Metadata = %v, want %v", got.Metadata, tc.metadata)
+			}
+		})
+	}
+}
+
+func TestDecodeUnknownTypeFallsBackToUnknownMessage(t *testing.T) {
+	type futureMessage struct {
+		Field string `msgpack:"field"`
+	}
+
+	wrapper := struct {
+		BecCodec struct {
+			EncoderName string      `msgpack:"encoder_name"`
+			TypeName    string      `msgpack:"type_name"`
+			Data        interface{} `msgpack:"data"`
+		} `msgpack:"__bec_codec__"`
+	}{}
+	wrapper.BecCodec.EncoderName = "BECMessage"
+	wrapper.BecCodec.TypeName = "ScanMessage"
+	wrapper.BecCodec.Data = futureMessage{Field: "value"}
+
+	data, err := msgpack.Marshal(wrapper)
+	if err != nil {
+		t.Fatalf("failed to marshal test fixture: %v", err)
+	}
+
+	decoded, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode() error = %v, want a fallback UnknownMessage instead of an error", err)
+	}
+
+	unknown, ok := decoded.(*UnknownMessage)
+	if !ok {
+		t.Fatalf("Decode() returned %T, want *UnknownMessage", decoded)
+	}
+	if unknown.EncoderName != "BECMessage" || unknown.TypeName != "ScanMessage" {
+		t.Errorf("UnknownMessage = %+v, want EncoderName=BECMessage TypeName=ScanMessage", unknown)
+	}
+	if unknown.Summary() == "" {
+		t.Error("Summary() returned an empty string")
+	}
+}
+
+func TestDecodeMalformedEnvelope(t *testing.T) {
+	_, err := Decode([]byte("not msgpack"))
+	if err == nil {
+		t.Fatal("Decode() error = nil, want an error for a malformed envelope")
+	}
+}
+
+func TestRegisterAddsDecodableType(t *testing.T) {
+	Register("BECMessage", "PingMessageForTest", func() Message { return &pingMessageWrapper{} })
+
+	data, err := Encode(&pingMessageWrapper{})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	decoded, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if _, ok := decoded.(*pingMessageWrapper); !ok {
+		t.Fatalf("Decode() returned %T, want *pingMessageWrapper", decoded)
+	}
+}
+
+type pingMessageWrapper struct{}
+
+func (p *pingMessageWrapper) Envelope() (string, string) { return "BECMessage", "PingMessageForTest" }
@@ -0,0 +1,27 @@
+package beccodec
+
+func init() {
+	Register("BECMessage", "VariableMessage", func() Message { return &VariableMessage{} })
+}
+
+// VariableMessage carries an arbitrary named value, e.g. the active
+// pgroup published to info/account.
+type VariableMessage struct {
+	MsgType  string            `msgpack:"msg_type" json:"msg_type"`
+	Value    interface{}       `msgpack:"value" json:"value"`
+	Metadata map[string]string `msgpack:"metadata" json:"metadata"`
+}
+
+// NewVariableMessage builds a VariableMessage ready to be passed to
+// Encode.
+func NewVariableMessage(value interface{}, metadata map[string]string) *VariableMessage {
+	return &VariableMessage{
+		MsgType:  "var_message",
+		Value:    value,
+		Metadata: metadata,
+	}
+}
+
+func (m *VariableMessage) Envelope() (string, string) {
+	return "BECMessage", "VariableMessage"
+}
@@ -0,0 +1,98 @@
+package redisconn
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileConfig mirrors the subset of connection flags that can be set from
+// ~/.bec/config.yaml. Precedence, lowest to highest, is: built-in default,
+// config file, BEC_REDIS_* environment variable, explicit CLI flag.
+type FileConfig struct {
+	RedisHost               string `yaml:"redis_host"`
+	RedisPort               string `yaml:"redis_port"`
+	RedisUsername           string `yaml:"redis_username"`
+	RedisPassword           string `yaml:"redis_password"`
+	RedisDB                 int    `yaml:"redis_db"`
+	RedisTLS                bool   `yaml:"redis_tls"`
+	RedisTLSCA              string `yaml:"redis_tls_ca"`
+	RedisTLSCert            string `yaml:"redis_tls_cert"`
+	RedisTLSKey             string `yaml:"redis_tls_key"`
+	RedisInsecureSkipVerify bool   `yaml:"redis_insecure_skip_verify"`
+}
+
+// DefaultConfigPath returns ~/.bec/config.yaml, or "" if the home
+// directory cannot be determined.
+func DefaultConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".bec", "config.yaml")
+}
+
+// LoadFileConfig reads a FileConfig from path. A missing file is not an
+// error; it simply yields a zero-value FileConfig so config file use
+// stays optional.
+func LoadFileConfig(path string) (FileConfig, error) {
+	var fc FileConfig
+	if path == "" {
+		return fc, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fc, nil
+		}
+		return fc, fmt.Errorf("redisconn: failed to read %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, &fc); err != nil {
+		return fc, fmt.Errorf("redisconn: failed to parse %s: %w", path, err)
+	}
+	return fc, nil
+}
+
+// StringSetting resolves a string setting using env > file > fallback,
+// the precedence to use for a flag's default value so that an explicit
+// CLI flag (which overrides any default) remains the final word.
+func StringSetting(envKey, fileVal, fallback string) string {
+	if v, ok := os.LookupEnv(envKey); ok {
+		return v
+	}
+	if fileVal != "" {
+		return fileVal
+	}
+	return fallback
+}
+
+// BoolSetting resolves a boolean setting using env > file > fallback.
+func BoolSetting(envKey string, fileVal, fallback bool) bool {
+	if v, ok := os.LookupEnv(envKey); ok {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	if fileVal {
+		return true
+	}
+	return fallback
+}
+
+// IntSetting resolves an integer setting using env > file > fallback.
+func IntSetting(envKey string, fileVal, fallback int) int {
+	if v, ok := os.LookupEnv(envKey); ok {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	if fileVal != 0 {
+		return fileVal
+	}
+	return fallback
+}
@@ -0,0 +1,119 @@
+package redisconn
+
+import (
+	"os"
+	"testing"
+)
+
+func TestStringSetting(t *testing.T) {
+	cases := []struct {
+		name     string
+		env      string
+		envSet   bool
+		fileVal  string
+		fallback string
+		want     string
+	}{
+		{name: "env wins over file and fallback", env: "from-env", envSet: true, fileVal: "from-file", fallback: "from-fallback", want: "from-env"},
+		{name: "file wins over fallback when env unset", envSet: false, fileVal: "from-file", fallback: "from-fallback", want: "from-file"},
+		{name: "fallback used when env and file unset", envSet: false, fileVal: "", fallback: "from-fallback", want: "from-fallback"},
+		{name: "empty env value still wins over file", env: "", envSet: true, fileVal: "from-file", fallback: "from-fallback", want: ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			const key = "BEC_REDISCONN_TEST_STRING"
+			if tc.envSet {
+				t.Setenv(key, tc.env)
+			}
+			if got := StringSetting(key, tc.fileVal, tc.fallback); got != tc.want {
+				t.Errorf("StringSetting() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBoolSetting(t *testing.T) {
+	cases := []struct {
+		name     string
+		env      string
+		envSet   bool
+		fileVal  bool
+		fallback bool
+		want     bool
+	}{
+		{name: "env true wins over file false", env: "true", envSet: true, fileVal: false, fallback: false, want: true},
+		{name: "env false wins over file true", env: "false", envSet: true, fileVal: true, fallback: true, want: false},
+		{name: "invalid env value falls through to file", env: "not-a-bool", envSet: true, fileVal: true, fallback: false, want: true},
+		{name: "file wins over fallback when env unset", envSet: false, fileVal: true, fallback: false, want: true},
+		{name: "fallback used when env and file unset", envSet: false, fileVal: false, fallback: true, want: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			const key = "BEC_REDISCONN_TEST_BOOL"
+			if tc.envSet {
+				t.Setenv(key, tc.env)
+			}
+			if got := BoolSetting(key, tc.fileVal, tc.fallback); got != tc.want {
+				t.Errorf("BoolSetting() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIntSetting(t *testing.T) {
+	cases := []struct {
+		name     string
+		env      string
+		envSet   bool
+		fileVal  int
+		fallback int
+		want     int
+	}{
+		{name: "env wins over file and fallback", env: "7", envSet: true, fileVal: 3, fallback: 1, want: 7},
+		{name: "invalid env value falls through to file", env: "nope", envSet: true, fileVal: 3, fallback: 1, want: 3},
+		{name: "file wins over fallback when env unset", envSet: false, fileVal: 3, fallback: 1, want: 3},
+		{name: "fallback used when env unset and file zero", envSet: false, fileVal: 0, fallback: 1, want: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			const key = "BEC_REDISCONN_TEST_INT"
+			if tc.envSet {
+				t.Setenv(key, tc.env)
+			}
+			if got := IntSetting(key, tc.fileVal, tc.fallback); got != tc.want {
+				t.Errorf("IntSetting() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLoadFileConfigMissingFileIsNotError(t *testing.T) {
+	fc, err := LoadFileConfig("/nonexistent/path/to/config.yaml")
+	if err != nil {
+		t.Fatalf("LoadFileConfig() error = %v, want nil for a missing file", err)
+	}
+	if fc != (FileConfig{}) {
+		t.Errorf("LoadFileConfig() = %+v, want zero value", fc)
+	}
+}
+
+func TestLoadFileConfigParsesYAML(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/config.yaml"
+	yamlContent := "redis_host: awi-bec-001\nredis_port: \"6380\"\nredis_db: 2\nredis_tls: true\n"
+	if err := os.WriteFile(path, []byte(yamlContent), 0o600); err != nil {
+		t.Fatalf("failed to write test fixture: %v", err)
+	}
+
+	fc, err := LoadFileConfig(path)
+	if err != nil {
+		t.Fatalf("LoadFileConfig() error = %v", err)
+	}
+	want := FileConfig{RedisHost: "awi-bec-001", RedisPort: "6380", RedisDB: 2, RedisTLS: true}
+	if fc != want {
+		t.Errorf("LoadFileConfig() = %+v, want %+v", fc, want)
+	}
+}
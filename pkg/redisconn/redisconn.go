@@ -0,0 +1,180 @@
+// Package redisconn builds a redis.UniversalClient for BEC Go tools,
+// hiding the differences between standalone, Sentinel, and Cluster
+// deployments behind a single configuration struct.
+package redisconn
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Mode selects which Redis topology to connect to.
+type Mode string
+
+const (
+	ModeStandalone Mode = "standalone"
+	ModeSentinel   Mode = "sentinel"
+	ModeCluster    Mode = "cluster"
+)
+
+// Config describes how to reach Redis. Addr is used for standalone mode;
+// SentinelAddrs/MasterName are used for sentinel mode; ClusterAddrs is
+// used for cluster mode.
+type Config struct {
+	Mode Mode
+
+	Addr string
+
+	MasterName    string
+	SentinelAddrs []string
+
+	ClusterAddrs []string
+
+	Username string
+	Password string
+	// DB selects the logical database (standalone and Sentinel modes only;
+	// Cluster deployments do not support SELECT).
+	DB int
+
+	TLS TLSConfig
+
+	// PingTimeout bounds each connection health check.
+	PingTimeout time.Duration
+	// PingRetries is the number of additional attempts made if the
+	// initial Ping fails, e.g. while a Sentinel failover is in progress.
+	PingRetries int
+	// PingRetryWait is the delay between retry attempts.
+	PingRetryWait time.Duration
+}
+
+// TLSConfig describes optional TLS settings for the Redis connection.
+type TLSConfig struct {
+	Enabled            bool
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	InsecureSkipVerify bool
+}
+
+// Build returns the *tls.Config to pass to go-redis, or nil if TLS is
+// disabled.
+func (c TLSConfig) Build() (*tls.Config, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+
+	tlsCfg := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if c.CAFile != "" {
+		caCert, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("redisconn: failed to read --redis-tls-ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("redisconn: no certificates found in --redis-tls-ca %q", c.CAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("redisconn: failed to load --redis-tls-cert/--redis-tls-key: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsCfg, nil
+}
+
+// New builds a redis.UniversalClient for the given configuration and
+// verifies connectivity with a bounded retry loop, so callers can ride
+// out a Sentinel-driven master failover instead of failing outright.
+func New(ctx context.Context, cfg Config) (redis.UniversalClient, error) {
+	tlsCfg, err := cfg.TLS.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	var rdb redis.UniversalClient
+
+	switch cfg.Mode {
+	case "", ModeStandalone:
+		rdb = redis.NewClient(&redis.Options{
+			Addr:      cfg.Addr,
+			Username:  cfg.Username,
+			Password:  cfg.Password,
+			DB:        cfg.DB,
+			TLSConfig: tlsCfg,
+		})
+	case ModeSentinel:
+		if cfg.MasterName == "" {
+			return nil, fmt.Errorf("redisconn: --redis-master-name is required in sentinel mode")
+		}
+		if len(cfg.SentinelAddrs) == 0 {
+			return nil, fmt.Errorf("redisconn: at least one --redis-sentinel-addr is required in sentinel mode")
+		}
+		rdb = redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:    cfg.MasterName,
+			SentinelAddrs: cfg.SentinelAddrs,
+			Username:      cfg.Username,
+			Password:      cfg.Password,
+			DB:            cfg.DB,
+			TLSConfig:     tlsCfg,
+		})
+	case ModeCluster:
+		if len(cfg.ClusterAddrs) == 0 {
+			return nil, fmt.Errorf("redisconn: at least one --redis-cluster-addr is required in cluster mode")
+		}
+		rdb = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:     cfg.ClusterAddrs,
+			Username:  cfg.Username,
+			Password:  cfg.Password,
+			TLSConfig: tlsCfg,
+		})
+	default:
+		return nil, fmt.Errorf("redisconn: unknown mode %q", cfg.Mode)
+	}
+
+	if err := pingWithRetry(ctx, rdb, cfg); err != nil {
+		return nil, err
+	}
+
+	return rdb, nil
+}
+
+func pingWithRetry(ctx context.Context, rdb redis.UniversalClient, cfg Config) error {
+	timeout := cfg.PingTimeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	wait := cfg.PingRetryWait
+	if wait <= 0 {
+		wait = time.Second
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= cfg.PingRetries; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, timeout)
+		_, err := rdb.Ping(pingCtx).Result()
+		cancel()
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if attempt < cfg.PingRetries {
+			time.Sleep(wait)
+		}
+	}
+
+	return fmt.Errorf("redisconn: failed to connect to Redis after %d attempt(s): %w", cfg.PingRetries+1, lastErr)
+}
@@ -0,0 +1,101 @@
+package redisconn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTLSConfigBuildDisabled(t *testing.T) {
+	tlsCfg, err := TLSConfig{Enabled: false}.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v, want nil", err)
+	}
+	if tlsCfg != nil {
+		t.Errorf("Build() = %+v, want nil when TLS is disabled", tlsCfg)
+	}
+}
+
+func TestTLSConfigBuildEnabledNoFiles(t *testing.T) {
+	tlsCfg, err := TLSConfig{Enabled: true, InsecureSkipVerify: true}.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if tlsCfg == nil {
+		t.Fatal("Build() = nil, want a *tls.Config when TLS is enabled")
+	}
+	if !tlsCfg.InsecureSkipVerify {
+		t.Error("Build() did not propagate InsecureSkipVerify")
+	}
+}
+
+func TestTLSConfigBuildWithCA(t *testing.T) {
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.pem")
+	writeSelfSignedCert(t, caPath)
+
+	tlsCfg, err := TLSConfig{Enabled: true, CAFile: caPath}.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if tlsCfg.RootCAs == nil {
+		t.Error("Build() did not populate RootCAs from --redis-tls-ca")
+	}
+}
+
+func TestTLSConfigBuildBadCAFile(t *testing.T) {
+	_, err := TLSConfig{Enabled: true, CAFile: "/nonexistent/ca.pem"}.Build()
+	if err == nil {
+		t.Fatal("Build() error = nil, want an error for a missing CA file")
+	}
+}
+
+func TestTLSConfigBuildEmptyCAFile(t *testing.T) {
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "empty-ca.pem")
+	if err := os.WriteFile(caPath, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("failed to write test fixture: %v", err)
+	}
+
+	_, err := TLSConfig{Enabled: true, CAFile: caPath}.Build()
+	if err == nil {
+		t.Fatal("Build() error = nil, want an error when the CA file has no certificates")
+	}
+}
+
+// writeSelfSignedCert writes a throwaway self-signed CA certificate to
+// path, for exercising TLSConfig.Build's CA-loading path.
+func writeSelfSignedCert(t *testing.T, path string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate test key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "redisconn-test-ca"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create test certificate: %v", err)
+	}
+
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	if err := os.WriteFile(path, pemBytes, 0o600); err != nil {
+		t.Fatalf("failed to write test certificate: %v", err)
+	}
+}